@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConformanceReport holds the results of a Gateway API conformance test run,
+// suitable for submission upstream for implementation status reporting.
+//
+// +k8s:deepcopy-gen=true
+type ConformanceReport struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Date is the timestamp at which the conformance report was generated, in
+	// RFC3339 format.
+	Date string `json:"date"`
+
+	// Implementation describes the implementation under test that produced
+	// this report.
+	Implementation Implementation `json:"implementation"`
+
+	// Mode records the run configuration the implementation was tested
+	// under (e.g. "default" or "strict"), if one was set.
+	Mode string `json:"mode,omitempty"`
+
+	// GatewayAPIVersion is the bundle version of the Gateway API CRDs that
+	// were installed on the cluster the conformance tests ran against, as
+	// read from the `gateway.networking.k8s.io/bundle-version` annotation on
+	// the installed CRDs.
+	GatewayAPIVersion string `json:"gatewayAPIVersion"`
+
+	// ProfileReports documents the test results for each conformance profile
+	// that was exercised during the run.
+	ProfileReports []ProfileReport `json:"profileReports"`
+}
+
+// Implementation identifies the project under conformance test, mirroring
+// the identifying information most downstream conformance reports already
+// collect and publish alongside their results.
+//
+// +k8s:deepcopy-gen=true
+type Implementation struct {
+	// Organization is the name of the organization or individual that
+	// maintains the implementation under test, e.g. "Acme Corp".
+	Organization string `json:"organization"`
+
+	// Project is the name of the implementation under test, e.g. "widget-gateway".
+	Project string `json:"project"`
+
+	// URL is a link to the implementation's project page or repository.
+	URL string `json:"url"`
+
+	// Version is the version of the implementation under test.
+	Version string `json:"version"`
+
+	// Contact is a list of contacts for the implementation, such as GitHub
+	// usernames or team aliases, to reach in case of conformance questions.
+	Contact []string `json:"contact"`
+}
+
+// ProfileReport documents the test results that were collected for a single
+// conformance profile during a conformance test suite run.
+//
+// +k8s:deepcopy-gen=true
+type ProfileReport struct {
+	// Name is the name of the conformance profile, e.g. "HTTP".
+	Name string `json:"name"`
+
+	// Variant is the name of the ConformanceVariant this report was
+	// collected under, e.g. a router flavor or data-plane topology. It is
+	// empty when the suite was run without variants.
+	Variant string `json:"variant,omitempty"`
+
+	// VariantLabels carries the arbitrary labels the variant was
+	// configured with, for implementations that need to record more than
+	// a bare name (e.g. a router flavor and a topology mode together).
+	VariantLabels map[string]string `json:"variantLabels,omitempty"`
+
+	// CorePassed is the list of ShortNames of the tests gated by this
+	// profile's core features which passed.
+	CorePassed []string `json:"corePassed,omitempty"`
+
+	// CoreFailed is the list of ShortNames of the tests gated by this
+	// profile's core features which failed.
+	CoreFailed []string `json:"coreFailed,omitempty"`
+
+	// ExtendedSupported is the list of ShortNames of the tests gated
+	// exclusively by one of this profile's extended (optional) features
+	// which ran and passed, demonstrating that the implementation's
+	// declared support for that feature holds up.
+	ExtendedSupported []string `json:"extendedSupported,omitempty"`
+
+	// ExtendedUnsupported is the list of ShortNames of the tests gated
+	// exclusively by one of this profile's extended (optional) features
+	// which did not pass: either skipped (because the implementation didn't
+	// declare support for the feature, or via SkipTests), or run and failed
+	// despite the implementation claiming support.
+	ExtendedUnsupported []string `json:"extendedUnsupported,omitempty"`
+
+	// SkippedTests is the list of ShortNames of the core tests belonging to
+	// this profile which were not run, either because they were explicitly
+	// skipped via SkipTests, or because the implementation did not declare
+	// support for the features the test required.
+	SkippedTests []string `json:"skippedTests,omitempty"`
+
+	// FlakedTests is the list of ShortNames of the tests belonging to this
+	// profile which failed at least once before eventually passing within
+	// the configured FlakeAttempts budget. Every entry here is also present
+	// in CorePassed or ExtendedSupported; FlakedTests exists so reviewers
+	// can distinguish a flaky pass from a clean one.
+	FlakedTests []string `json:"flakedTests,omitempty"`
+
+	// FeatureByTest maps each test's ShortName to the SupportedFeature that
+	// gated its inclusion in this profile, for tests that declared one.
+	FeatureByTest map[string]string `json:"featureByTest,omitempty"`
+}
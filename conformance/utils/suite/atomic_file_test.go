@@ -0,0 +1,60 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFileAtomicWritesAndOverwrites(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "report.yaml")
+
+	require.NoError(t, writeFileAtomic(target, []byte("first")))
+	data, err := os.ReadFile(target)
+	require.NoError(t, err)
+	require.Equal(t, "first", string(data))
+
+	require.NoError(t, writeFileAtomic(target, []byte("second")))
+	data, err = os.ReadFile(target)
+	require.NoError(t, err)
+	require.Equal(t, "second", string(data))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "no temp files should be left behind after a successful write")
+}
+
+func TestWriteFileAtomicCleansUpTempFileOnRenameFailure(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "report.yaml")
+	// Occupy the destination path with a directory so the rename into
+	// place fails.
+	require.NoError(t, os.Mkdir(target, 0o755))
+
+	err := writeFileAtomic(target, []byte("data"))
+	require.Error(t, err)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "no leftover temp file should remain in the directory")
+	require.True(t, entries[0].IsDir())
+}
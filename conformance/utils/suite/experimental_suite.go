@@ -20,13 +20,19 @@ limitations under the License.
 package suite
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
 	"sync"
 	"testing"
 	"time"
 
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
 	confv1a1 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha1"
 	"sigs.k8s.io/gateway-api/conformance/utils/config"
@@ -34,6 +40,13 @@ import (
 	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
 )
 
+// gatewayAPICRDGroup is the API group used by all Gateway API CRDs.
+const gatewayAPICRDGroup = "gateway.networking.k8s.io"
+
+// gatewayAPIBundleVersionAnnotation is the annotation that the Gateway API
+// CRDs carry to record which release bundle they came from.
+const gatewayAPIBundleVersionAnnotation = "gateway.networking.k8s.io/bundle-version"
+
 // -----------------------------------------------------------------------------
 // Conformance Test Suite - Public Types
 // -----------------------------------------------------------------------------
@@ -54,6 +67,29 @@ type ExperimentalConformanceTestSuite struct {
 	// marked as not supported, and is used for reporting the test results.
 	unsupportedFeatures sets.Set[SupportedFeature]
 
+	// implementation describes the implementation under test, and is carried
+	// through unchanged into the ConformanceReport.
+	implementation confv1a1.Implementation
+
+	// mode is recorded in the ConformanceReport to distinguish between
+	// different run configurations of the same implementation.
+	mode string
+
+	// flakeAttempts is the maximum number of times a failing test is run
+	// before it is recorded as a true failure.
+	flakeAttempts int
+
+	// retryableErrors is consulted to decide whether a panic recovered from
+	// a test run should be retried rather than treated as fatal.
+	retryableErrors []func(error) bool
+
+	// variants are the implementation-specific variants the suite was
+	// configured to run every test under.
+	variants []ConformanceVariant
+
+	// reporters are notified of test lifecycle events as the suite runs.
+	reporters []Reporter
+
 	// lock is a mutex to help ensure thread safety of the test suite object.
 	lock sync.RWMutex
 }
@@ -63,6 +99,97 @@ type ExperimentalConformanceOptions struct {
 	Options
 
 	ConformanceProfiles sets.Set[ConformanceProfileName]
+
+	// Implementation describes the implementation under test. It is carried
+	// through to the ConformanceReport unchanged so that reports are
+	// self-describing when submitted upstream.
+	Implementation confv1a1.Implementation
+
+	// Mode is recorded in the ConformanceReport to distinguish between
+	// different run configurations of the same implementation (e.g.
+	// "default" vs "strict"). It has no effect on which tests are run.
+	Mode string
+
+	// FlakeAttempts is the maximum number of times a failing test is run,
+	// counting its first run, before it is recorded as a true failure. A
+	// value of 0 or 1 disables retries. A test that panics is not retried
+	// unless the panic value is an error matched by RetryableErrors, and a
+	// panic whose error is a context cancellation or deadline is never
+	// retried. A test that fails through t.Error or t.Fatal is retried
+	// whatever caused the failure, context cancellation included.
+	FlakeAttempts int
+
+	// RetryableErrors is consulted, in order, when a test run panics with
+	// an error value. If any function returns true for that error, the
+	// panic is treated as an ordinary (retryable) failure instead of being
+	// allowed to crash the test binary.
+	RetryableErrors []func(error) bool
+
+	// Variants, if provided, causes the full set of tests to be run once
+	// per variant (e.g. once per router flavor or data-plane topology)
+	// instead of just once, eliminating the need for callers to drive
+	// their own shell matrix across repeated test binary invocations.
+	Variants []ConformanceVariant
+
+	// Reporters, if provided, are notified of test lifecycle events as Run
+	// and Report execute, e.g. to drive a JUnit file, GitHub Actions
+	// annotations, or a webhook for a live dashboard.
+	Reporters []Reporter
+}
+
+// ConformanceVariant describes an implementation-specific axis (such as a
+// router flavor or data-plane topology) to run the full conformance suite
+// under, in addition to or instead of a single default run.
+type ConformanceVariant struct {
+	// Name identifies the variant. It is used as the subtest name that
+	// tests for this variant are nested under, and as the
+	// ProfileReport.Variant value.
+	Name string
+
+	// Labels carries arbitrary metadata describing the variant (e.g.
+	// {"router": "envoy"}), surfaced on the ProfileReport so a single
+	// report can be told apart from others along whatever axes matter to
+	// the implementation.
+	Labels map[string]string
+
+	// ReapplyBaseManifests re-applies the suite's base manifests before
+	// this variant's tests run, for variants that need a clean starting
+	// state rather than whatever the previous variant left behind.
+	ReapplyBaseManifests bool
+
+	// Setup, if provided, runs once before this variant's tests.
+	Setup func(t *testing.T, suite *ExperimentalConformanceTestSuite) error
+
+	// Teardown, if provided, runs once after this variant's tests,
+	// regardless of whether they passed.
+	Teardown func(t *testing.T, suite *ExperimentalConformanceTestSuite) error
+}
+
+// ReportOutputFormat is the serialization format used by WriteReport.
+type ReportOutputFormat string
+
+const (
+	// ReportOutputFormatYAML serializes the report as YAML.
+	ReportOutputFormatYAML ReportOutputFormat = "yaml"
+
+	// ReportOutputFormatJSON serializes the report as JSON.
+	ReportOutputFormatJSON ReportOutputFormat = "json"
+)
+
+// ReportOptions configures how WriteReport serializes and persists a
+// ConformanceReport.
+type ReportOptions struct {
+	// Output is the file path the report will be written to.
+	Output string
+
+	// Format is the serialization format to use. Defaults to
+	// ReportOutputFormatYAML.
+	Format ReportOutputFormat
+
+	// AllowOverwrite permits WriteReport to replace an existing file at
+	// Output. If false and a file already exists at Output, WriteReport
+	// returns an error instead of overwriting it.
+	AllowOverwrite bool
 }
 
 // New returns a new ConformanceTestSuite.
@@ -77,6 +204,12 @@ func NewExperimentalConformanceTestSuite(s ExperimentalConformanceOptions) (*Exp
 	suite := &ExperimentalConformanceTestSuite{
 		results:             make(map[string]testResult),
 		unsupportedFeatures: sets.New[SupportedFeature](),
+		implementation:      s.Implementation,
+		mode:                s.Mode,
+		flakeAttempts:       s.FlakeAttempts,
+		retryableErrors:     s.RetryableErrors,
+		variants:            s.Variants,
+		reporters:           s.Reporters,
 	}
 
 	// test suite callers are required to provide a conformance profile OR at
@@ -184,24 +317,85 @@ func (suite *ExperimentalConformanceTestSuite) Run(t *testing.T, tests []Conform
 	suite.results = nil
 	suite.lock.Unlock()
 
-	// run all tests and collect the test results for conformance reporting
+	// however a variant's Setup/Teardown or an individual test exits (a
+	// normal return, or a t.Fatal/t.FailNow unwinding via runtime.Goexit),
+	// the suite must stop reporting itself as running so that later Run and
+	// Report calls aren't permanently locked out.
 	results := make(map[string]testResult)
-	for _, test := range tests {
-		succeeded := t.Run(test.ShortName, func(t *testing.T) {
-			test.Run(t, &suite.ConformanceTestSuite)
-		})
-		results[test.ShortName] = testResult{
-			test:      test,
-			succeeded: succeeded,
-		}
+	defer func() {
+		suite.lock.Lock()
+		suite.running = false
+		suite.results = results
+		suite.lock.Unlock()
+	}()
+
+	// run all tests, once per configured variant, and collect the test
+	// results for conformance reporting
+	variants := suite.variants
+	if len(variants) == 0 {
+		variants = []ConformanceVariant{{}}
 	}
 
-	// now that the tests have completed, mark the test suite as not running
-	// and report the test results.
-	suite.lock.Lock()
-	suite.running = false
-	suite.results = results
-	suite.lock.Unlock()
+	for _, variant := range variants {
+		runVariant := func(t *testing.T) {
+			if variant.ReapplyBaseManifests {
+				suite.Setup(t)
+			}
+			if variant.Setup != nil {
+				if err := variant.Setup(t, suite); err != nil {
+					t.Fatalf("failed to set up variant %q: %v", variant.Name, err)
+				}
+			}
+
+			for _, test := range tests {
+				resultKey := test.ShortName
+				if variant.Name != "" {
+					resultKey = fmt.Sprintf("%s/%s", variant.Name, test.ShortName)
+				}
+
+				suite.notifyTestStart(test)
+
+				if skipReason := suite.skipReasonFor(test); skipReason != "" {
+					succeeded := t.Run(test.ShortName, func(t *testing.T) {
+						t.Skip(skipReason)
+					})
+					result := testResult{
+						test:      test,
+						succeeded: succeeded,
+						skipped:   true,
+						variant:   variant.Name,
+					}
+					results[resultKey] = result
+					suite.notifyTestFinish(outcomeFor(result))
+					continue
+				}
+
+				result := suite.runWithRetries(t, test)
+				result.variant = variant.Name
+				results[resultKey] = result
+				suite.notifyTestFinish(outcomeFor(result))
+			}
+
+			if variant.Teardown != nil {
+				if err := variant.Teardown(t, suite); err != nil {
+					t.Fatalf("failed to tear down variant %q: %v", variant.Name, err)
+				}
+			}
+		}
+
+		// The unnamed default variant runs inline rather than through
+		// t.Run, to avoid nesting every caller's existing subtests one
+		// level deeper (which would change their fully-qualified names and
+		// break `go test -run TestX/SomeTest`-style filtering). A
+		// t.Fatalf from Setup/Teardown still unwinds safely here: the
+		// deferred running/results reset above runs regardless of how this
+		// goroutine exits.
+		if variant.Name == "" {
+			runVariant(t)
+		} else {
+			t.Run(variant.Name, runVariant)
+		}
+	}
 
 	return nil
 }
@@ -216,22 +410,199 @@ func (suite *ExperimentalConformanceTestSuite) Report() (*confv1a1.ConformanceRe
 	}
 	defer suite.lock.RUnlock()
 
-	profileReports := newReports()
+	gatewayAPIVersion, err := suite.detectGatewayAPIVersion(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine installed Gateway API version: %w", err)
+	}
+
+	reports := newReports()
 	for _, testResult := range suite.results {
-		if err := profileReports.addTestResults(testResult); err != nil {
+		if err := reports.addTestResults(testResult); err != nil {
 			return nil, err
 		}
 	}
-	profileReports.compileResults()
-
-	// TODO: need to know which tests were skipped and submit those before
-	// the results are compiled.
+	reports.compileResults()
 
-	// TODO: add handling for supported and unsupported extended features
+	profileReports := reports.list()
+	variantLabels := suite.variantLabels()
+	for i := range profileReports {
+		if labels, ok := variantLabels[profileReports[i].Variant]; ok {
+			profileReports[i].VariantLabels = labels
+		}
+	}
 
-	return &confv1a1.ConformanceReport{
+	report := &confv1a1.ConformanceReport{
 		Date:              time.Now().Format(time.RFC3339),
-		GatewayAPIVersion: "TODO",
-		ProfileReports:    profileReports.list(),
-	}, nil
-}
\ No newline at end of file
+		Implementation:    suite.implementation,
+		Mode:              suite.mode,
+		GatewayAPIVersion: gatewayAPIVersion,
+		ProfileReports:    profileReports,
+	}
+
+	suite.notifySuiteFinish(report)
+
+	return report, nil
+}
+
+// variantLabels returns the configured Labels for every named variant, for
+// attaching to the corresponding ProfileReports.
+func (suite *ExperimentalConformanceTestSuite) variantLabels() map[string]map[string]string {
+	labels := make(map[string]map[string]string, len(suite.variants))
+	for _, variant := range suite.variants {
+		if variant.Name != "" && len(variant.Labels) > 0 {
+			labels[variant.Name] = variant.Labels
+		}
+	}
+	return labels
+}
+
+// WriteReport generates a ConformanceReport for the previously completed
+// test run and writes it to opts.Output in opts.Format, atomically so that
+// readers never observe a partially written file. It is meant to be called
+// after Run, e.g. under `go test -run`, without requiring callers to write
+// their own marshaling and file-writing glue.
+func (suite *ExperimentalConformanceTestSuite) WriteReport(opts ReportOptions) error {
+	report, err := suite.Report()
+	if err != nil {
+		return fmt.Errorf("failed to generate report: %w", err)
+	}
+
+	if opts.Output == "" {
+		return fmt.Errorf("report output path must be provided")
+	}
+
+	format := opts.Format
+	if format == "" {
+		format = ReportOutputFormatYAML
+	}
+
+	var data []byte
+	switch format {
+	case ReportOutputFormatYAML:
+		data, err = yaml.Marshal(report)
+	case ReportOutputFormatJSON:
+		data, err = json.MarshalIndent(report, "", "  ")
+	default:
+		return fmt.Errorf("unsupported report format %q", format)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to marshal report: %w", err)
+	}
+
+	if !opts.AllowOverwrite {
+		if _, err := os.Stat(opts.Output); err == nil {
+			return fmt.Errorf("report output file %q already exists and AllowOverwrite is false", opts.Output)
+		} else if !os.IsNotExist(err) {
+			return fmt.Errorf("failed to check existing report output file %q: %w", opts.Output, err)
+		}
+	}
+
+	return writeFileAtomic(opts.Output, data)
+}
+
+// skipReasonFor returns a human-readable reason why the given test should be
+// skipped rather than run, or the empty string if it should be run.
+func (suite *ExperimentalConformanceTestSuite) skipReasonFor(test ConformanceTest) string {
+	if suite.SkipTests.Has(test.ShortName) {
+		return "skipping test due to SkipTests option"
+	}
+
+	for _, feature := range test.Features {
+		if suite.unsupportedFeatures.Has(feature) {
+			return fmt.Sprintf("skipping test because feature %q is not supported", feature)
+		}
+	}
+
+	return ""
+}
+
+// runWithRetries runs test, re-running it under successively suffixed
+// subtest names (".retry1", ".retry2", ...) while it fails, for at most
+// suite.flakeAttempts runs in all, and records how many attempts were
+// needed. A test that panics is not retried and its panic propagates as
+// usual, unless recoverRetryablePanic finds the panic value to be an error
+// matched by suite.retryableErrors; a panic due to context cancellation is
+// never retried.
+func (suite *ExperimentalConformanceTestSuite) runWithRetries(t *testing.T, test ConformanceTest) testResult {
+	maxAttempts := suite.flakeAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	var succeeded bool
+	var attempts int
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		attempts = attempt
+
+		name := test.ShortName
+		if attempt > 1 {
+			name = fmt.Sprintf("%s.retry%d", test.ShortName, attempt-1)
+		}
+
+		succeeded = t.Run(name, func(t *testing.T) {
+			defer suite.recoverRetryablePanic(t)
+			test.Run(t, &suite.ConformanceTestSuite)
+		})
+
+		if succeeded {
+			break
+		}
+	}
+
+	return testResult{
+		test:      test,
+		succeeded: succeeded,
+		attempts:  attempts,
+		flaked:    succeeded && attempts > 1,
+	}
+}
+
+// recoverRetryablePanic recovers a panic from a test run. By default a panic
+// is never retried: it is re-panicked so the test binary fails loudly as it
+// normally would, the same as context cancellation, which is never
+// retryable under any configuration. The one opt-in exception is a panic
+// whose recovered value is an error that a configured RetryableErrors
+// function matches — that panic is reported as an ordinary test failure
+// instead, so runWithRetries retries it like any other failed attempt.
+// Without RetryableErrors configured, this carve-out never applies.
+func (suite *ExperimentalConformanceTestSuite) recoverRetryablePanic(t *testing.T) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	err, ok := r.(error)
+	if !ok || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		panic(r)
+	}
+
+	for _, isRetryable := range suite.retryableErrors {
+		if isRetryable(err) {
+			t.Errorf("recovered retryable error: %v", err)
+			return
+		}
+	}
+
+	panic(r)
+}
+
+// detectGatewayAPIVersion inspects the installed Gateway API CRDs and returns
+// the bundle version recorded in their `gateway.networking.k8s.io/bundle-version`
+// annotation, which all CRDs in a given release bundle are expected to share.
+func (suite *ExperimentalConformanceTestSuite) detectGatewayAPIVersion(ctx context.Context) (string, error) {
+	crds := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := suite.Client.List(ctx, crds); err != nil {
+		return "", fmt.Errorf("failed to list CRDs to detect the installed Gateway API version: %w", err)
+	}
+
+	for _, crd := range crds.Items {
+		if crd.Spec.Group != gatewayAPICRDGroup {
+			continue
+		}
+		if version, ok := crd.Annotations[gatewayAPIBundleVersionAnnotation]; ok {
+			return version, nil
+		}
+	}
+
+	return "", fmt.Errorf("no installed Gateway API CRD carried a %q annotation", gatewayAPIBundleVersionAnnotation)
+}
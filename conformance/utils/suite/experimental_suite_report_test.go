@@ -0,0 +1,95 @@
+//go:build experimental
+// +build experimental
+
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSuiteForReport(t *testing.T) *ExperimentalConformanceTestSuite {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, apiextensionsv1.AddToScheme(scheme))
+
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "gateways.gateway.networking.k8s.io",
+			Annotations: map[string]string{gatewayAPIBundleVersionAnnotation: "v1.0.0"},
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{Group: gatewayAPICRDGroup},
+	}
+
+	return &ExperimentalConformanceTestSuite{
+		ConformanceTestSuite: ConformanceTestSuite{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(crd).Build(),
+		},
+		results: map[string]testResult{
+			"Basic": {
+				test:      ConformanceTest{ShortName: "Basic", Features: []SupportedFeature{SupportHTTPRoute}},
+				succeeded: true,
+			},
+		},
+	}
+}
+
+func TestWriteReportRefusesToOverwriteByDefault(t *testing.T) {
+	suite := newTestSuiteForReport(t)
+	out := filepath.Join(t.TempDir(), "report.yaml")
+
+	require.NoError(t, suite.WriteReport(ReportOptions{Output: out}))
+	first, err := os.ReadFile(out)
+	require.NoError(t, err)
+
+	err = suite.WriteReport(ReportOptions{Output: out})
+	require.Error(t, err, "a second write without AllowOverwrite must fail")
+
+	unchanged, err := os.ReadFile(out)
+	require.NoError(t, err)
+	require.Equal(t, first, unchanged)
+}
+
+func TestWriteReportOverwritesWhenAllowed(t *testing.T) {
+	suite := newTestSuiteForReport(t)
+	out := filepath.Join(t.TempDir(), "report.yaml")
+
+	require.NoError(t, suite.WriteReport(ReportOptions{Output: out}))
+	require.NoError(t, suite.WriteReport(ReportOptions{Output: out, AllowOverwrite: true}))
+}
+
+func TestWriteReportJSONFormat(t *testing.T) {
+	suite := newTestSuiteForReport(t)
+	out := filepath.Join(t.TempDir(), "report.json")
+
+	require.NoError(t, suite.WriteReport(ReportOptions{Output: out, Format: ReportOutputFormatJSON}))
+
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"gatewayAPIVersion": "v1.0.0"`)
+}
@@ -0,0 +1,107 @@
+//go:build experimental
+// +build experimental
+
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunWithRetriesSucceedsFirstTry(t *testing.T) {
+	suite := &ExperimentalConformanceTestSuite{flakeAttempts: 3}
+
+	calls := 0
+	result := suite.runWithRetries(t, ConformanceTest{
+		ShortName: "AlwaysPasses",
+		Run: func(t *testing.T, _ *ConformanceTestSuite) {
+			calls++
+		},
+	})
+
+	require.Equal(t, 1, calls)
+	require.True(t, result.succeeded)
+	require.Equal(t, 1, result.attempts)
+	require.False(t, result.flaked)
+}
+
+func TestRunWithRetriesFlakesThenSucceeds(t *testing.T) {
+	suite := &ExperimentalConformanceTestSuite{flakeAttempts: 3}
+
+	calls := 0
+	result := suite.runWithRetries(t, ConformanceTest{
+		ShortName: "PassesOnSecondTry",
+		Run: func(t *testing.T, _ *ConformanceTestSuite) {
+			calls++
+			if calls < 2 {
+				t.Fail()
+			}
+		},
+	})
+
+	require.Equal(t, 2, calls)
+	require.True(t, result.succeeded)
+	require.Equal(t, 2, result.attempts)
+	require.True(t, result.flaked)
+}
+
+func TestRunWithRetriesExhaustsAttempts(t *testing.T) {
+	suite := &ExperimentalConformanceTestSuite{flakeAttempts: 3}
+
+	calls := 0
+	result := suite.runWithRetries(t, ConformanceTest{
+		ShortName: "AlwaysFails",
+		Run: func(t *testing.T, _ *ConformanceTestSuite) {
+			calls++
+			t.Fail()
+		},
+	})
+
+	// Regression test for an off-by-one: attempts must equal the number of
+	// times Run was actually called (maxAttempts), not maxAttempts+1.
+	require.Equal(t, 3, calls)
+	require.False(t, result.succeeded)
+	require.Equal(t, 3, result.attempts)
+	require.False(t, result.flaked)
+}
+
+func TestRunWithRetriesRetriesMatchedPanicError(t *testing.T) {
+	retryableErr := errors.New("transient dial error")
+	suite := &ExperimentalConformanceTestSuite{
+		flakeAttempts:   2,
+		retryableErrors: []func(error) bool{func(err error) bool { return errors.Is(err, retryableErr) }},
+	}
+
+	calls := 0
+	result := suite.runWithRetries(t, ConformanceTest{
+		ShortName: "RecoversAndRetries",
+		Run: func(t *testing.T, _ *ConformanceTestSuite) {
+			calls++
+			if calls < 2 {
+				panic(retryableErr)
+			}
+		},
+	})
+
+	require.Equal(t, 2, calls)
+	require.True(t, result.succeeded)
+	require.True(t, result.flaked)
+}
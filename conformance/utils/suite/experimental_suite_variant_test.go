@@ -0,0 +1,90 @@
+//go:build experimental
+// +build experimental
+
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestRunResetsRunningStateEvenWhenVariantSetupFails(t *testing.T) {
+	suite := &ExperimentalConformanceTestSuite{
+		results:             make(map[string]testResult),
+		unsupportedFeatures: sets.New[SupportedFeature](),
+		variants: []ConformanceVariant{
+			{
+				Setup: func(t *testing.T, _ *ExperimentalConformanceTestSuite) error {
+					return errors.New("setup failed")
+				},
+			},
+		},
+	}
+
+	// The unnamed default variant runs inline in the goroutine that calls
+	// Run, so Setup's t.Fatalf unwinds via runtime.Goexit that goroutine
+	// end to end, and testing.T.Fail marks every ancestor *testing.T as
+	// failed too. Drive it via a standalone *testing.T with no parent, in
+	// its own goroutine, so the expected failure can't leak into this
+	// test's own result.
+	innerT := &testing.T{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = suite.Run(innerT, []ConformanceTest{{
+			ShortName: "Unreachable",
+			Run: func(t *testing.T, _ *ConformanceTestSuite) {
+				t.Fatal("should never run: variant Setup failed before any test could execute")
+			},
+		}})
+	}()
+	<-done
+
+	require.True(t, innerT.Failed(), "expected the failing Setup to fail the run")
+
+	// Before the fix, a t.Fatalf from a failing Setup unwound through Run
+	// without clearing suite.running, wedging every later Run/Report call
+	// (Report also checks suite.running) for the rest of the process.
+	require.False(t, suite.running)
+}
+
+func TestRunKeysResultsByVariantName(t *testing.T) {
+	suite := &ExperimentalConformanceTestSuite{
+		results:             make(map[string]testResult),
+		unsupportedFeatures: sets.New[SupportedFeature](),
+		variants: []ConformanceVariant{
+			{Name: "envoy"},
+			{Name: "nginx"},
+		},
+	}
+
+	err := suite.Run(t, []ConformanceTest{{
+		ShortName: "Basic",
+		Run:       func(t *testing.T, _ *ConformanceTestSuite) {},
+	}})
+	require.NoError(t, err)
+
+	require.Contains(t, suite.results, "envoy/Basic")
+	require.Contains(t, suite.results, "nginx/Basic")
+	require.Equal(t, "envoy", suite.results["envoy/Basic"].variant)
+	require.Equal(t, "nginx", suite.results["nginx/Basic"].variant)
+}
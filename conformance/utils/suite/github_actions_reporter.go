@@ -0,0 +1,65 @@
+//go:build experimental
+// +build experimental
+
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	confv1a1 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha1"
+)
+
+// GitHubActionsReporter emits GitHub Actions workflow command annotations
+// (`::error`/`::notice`) as tests complete, so failures surface directly in
+// a workflow run's summary and, when the test's SourceFile is set, at its
+// origin in the Files Changed view.
+type GitHubActionsReporter struct {
+	// Writer is where workflow commands are written. Defaults to os.Stdout,
+	// which is where GitHub Actions looks for them.
+	Writer io.Writer
+}
+
+func (r *GitHubActionsReporter) writer() io.Writer {
+	if r.Writer != nil {
+		return r.Writer
+	}
+	return os.Stdout
+}
+
+// OnTestStart implements Reporter.
+func (r *GitHubActionsReporter) OnTestStart(test ConformanceTest) {}
+
+// OnTestFinish implements Reporter.
+func (r *GitHubActionsReporter) OnTestFinish(outcome TestOutcome) {
+	switch {
+	case outcome.Skipped:
+		return
+	case outcome.Succeeded:
+		fmt.Fprintf(r.writer(), "::notice::%s passed\n", outcome.Test.ShortName)
+	case outcome.Test.SourceFile != "":
+		fmt.Fprintf(r.writer(), "::error file=%s::%s failed\n", outcome.Test.SourceFile, outcome.Test.ShortName)
+	default:
+		fmt.Fprintf(r.writer(), "::error::%s failed\n", outcome.Test.ShortName)
+	}
+}
+
+// OnSuiteFinish implements Reporter.
+func (r *GitHubActionsReporter) OnSuiteFinish(report *confv1a1.ConformanceReport) {}
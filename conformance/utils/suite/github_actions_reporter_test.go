@@ -0,0 +1,70 @@
+//go:build experimental
+// +build experimental
+
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitHubActionsReporterOnTestFinish(t *testing.T) {
+	tests := []struct {
+		name    string
+		outcome TestOutcome
+		want    string
+	}{
+		{
+			name:    "skipped test emits nothing",
+			outcome: TestOutcome{Test: ConformanceTest{ShortName: "Skipped"}, Skipped: true},
+			want:    "",
+		},
+		{
+			name:    "passing test emits a notice",
+			outcome: TestOutcome{Test: ConformanceTest{ShortName: "Passes"}, Succeeded: true},
+			want:    "::notice::Passes passed\n",
+		},
+		{
+			name:    "failing test without SourceFile emits a bare error",
+			outcome: TestOutcome{Test: ConformanceTest{ShortName: "Fails"}, Succeeded: false},
+			want:    "::error::Fails failed\n",
+		},
+		{
+			name: "failing test with SourceFile emits a file-annotated error",
+			outcome: TestOutcome{
+				Test:      ConformanceTest{ShortName: "Fails", SourceFile: "httproute.go"},
+				Succeeded: false,
+			},
+			want: "::error file=httproute.go::Fails failed\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			reporter := &GitHubActionsReporter{Writer: &buf}
+
+			reporter.OnTestFinish(tt.outcome)
+
+			require.Equal(t, tt.want, buf.String())
+		})
+	}
+}
@@ -0,0 +1,161 @@
+//go:build experimental
+// +build experimental
+
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"encoding/xml"
+	"sort"
+	"sync"
+
+	confv1a1 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha1"
+)
+
+// JUnitReporter is a Reporter that incrementally writes a Jenkins-compatible
+// JUnit XML report to Output as tests complete, one testsuite per
+// conformance profile and one testcase per test ShortName, so that CI
+// test-reporting dashboards have results to show even if the process is
+// killed mid-run. Write failures are swallowed, since Reporter methods have
+// no error return; JUnitReporter is best-effort by design.
+type JUnitReporter struct {
+	// Output is the file path the JUnit XML is (re)written to after every
+	// test completes.
+	Output string
+
+	mu     sync.Mutex
+	suites map[ConformanceProfileName]*junitTestSuite
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name          `xml:"testsuites"`
+	Suites  []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name       string           `xml:"name,attr"`
+	Classname  string           `xml:"classname,attr"`
+	Properties *junitProperties `xml:"properties,omitempty"`
+	Skipped    *junitSkipped    `xml:"skipped,omitempty"`
+	Failure    *junitFailure    `xml:"failure,omitempty"`
+}
+
+type junitProperties struct {
+	Properties []junitProperty `xml:"property"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr,omitempty"`
+}
+
+// OnTestStart implements Reporter.
+func (r *JUnitReporter) OnTestStart(test ConformanceTest) {}
+
+// OnTestFinish implements Reporter, recording outcome against every
+// conformance profile the test belongs to and flushing Output.
+func (r *JUnitReporter) OnTestFinish(outcome TestOutcome) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.suites == nil {
+		r.suites = make(map[ConformanceProfileName]*junitTestSuite)
+	}
+
+	testCase := junitTestCase{
+		Name:      outcome.Test.ShortName,
+		Classname: "GatewayAPIConformance",
+	}
+	if len(outcome.Test.Features) > 0 {
+		testCase.Properties = &junitProperties{
+			Properties: []junitProperty{{Name: "feature", Value: string(outcome.Test.Features[0])}},
+		}
+	}
+	switch {
+	case outcome.Skipped:
+		testCase.Skipped = &junitSkipped{}
+	case !outcome.Succeeded:
+		testCase.Failure = &junitFailure{Message: "test failed"}
+	}
+
+	for _, name := range ProfilesForTest(outcome.Test) {
+		testSuite, ok := r.suites[name]
+		if !ok {
+			testSuite = &junitTestSuite{Name: string(name)}
+			r.suites[name] = testSuite
+		}
+
+		testSuite.TestCases = append(testSuite.TestCases, testCase)
+		testSuite.Tests++
+		if testCase.Skipped != nil {
+			testSuite.Skipped++
+		}
+		if testCase.Failure != nil {
+			testSuite.Failures++
+		}
+	}
+
+	r.flushLocked()
+}
+
+// OnSuiteFinish implements Reporter. The JUnit file is already kept current
+// by OnTestFinish, so there's nothing left to do here.
+func (r *JUnitReporter) OnSuiteFinish(report *confv1a1.ConformanceReport) {}
+
+// flushLocked serializes the accumulated suites to Output. The caller must
+// hold r.mu.
+func (r *JUnitReporter) flushLocked() {
+	if r.Output == "" {
+		return
+	}
+
+	names := make([]string, 0, len(r.suites))
+	for name := range r.suites {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+
+	doc := junitTestSuites{}
+	for _, name := range names {
+		doc.Suites = append(doc.Suites, r.suites[ConformanceProfileName(name)])
+	}
+
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return
+	}
+	data = append([]byte(xml.Header), data...)
+
+	_ = writeFileAtomic(r.Output, data)
+}
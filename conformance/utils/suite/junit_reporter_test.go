@@ -0,0 +1,71 @@
+//go:build experimental
+// +build experimental
+
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJUnitReporterWritesPassSkipAndFailure(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "junit.xml")
+	reporter := &JUnitReporter{Output: out}
+
+	reporter.OnTestFinish(TestOutcome{
+		Test:      ConformanceTest{ShortName: "Passes", Features: []SupportedFeature{SupportHTTPRoute}},
+		Succeeded: true,
+	})
+	reporter.OnTestFinish(TestOutcome{
+		Test:    ConformanceTest{ShortName: "SkippedTest", Features: []SupportedFeature{SupportHTTPRoute}},
+		Skipped: true,
+	})
+	reporter.OnTestFinish(TestOutcome{
+		Test:      ConformanceTest{ShortName: "Fails", Features: []SupportedFeature{SupportHTTPRoute}},
+		Succeeded: false,
+	})
+
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+
+	var doc junitTestSuites
+	require.NoError(t, xml.Unmarshal(data, &doc))
+	require.Len(t, doc.Suites, 1)
+
+	suite := doc.Suites[0]
+	require.Equal(t, string(HTTPConformanceProfileName), suite.Name)
+	require.Equal(t, 3, suite.Tests)
+	require.Equal(t, 1, suite.Skipped)
+	require.Equal(t, 1, suite.Failures)
+	require.Len(t, suite.TestCases, 3)
+
+	byName := make(map[string]junitTestCase, len(suite.TestCases))
+	for _, tc := range suite.TestCases {
+		byName[tc.Name] = tc
+	}
+
+	require.Nil(t, byName["Passes"].Skipped)
+	require.Nil(t, byName["Passes"].Failure)
+	require.NotNil(t, byName["SkippedTest"].Skipped)
+	require.NotNil(t, byName["Fails"].Failure)
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	confv1a1 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha1"
+)
+
+// testResult captures the outcome of running a single ConformanceTest.
+type testResult struct {
+	test      ConformanceTest
+	succeeded bool
+	skipped   bool
+
+	// attempts is the number of times the test was run before its final
+	// outcome was reached. It is 1 unless the test failed and was retried.
+	attempts int
+
+	// flaked indicates that the test failed at least once before
+	// eventually succeeding.
+	flaked bool
+
+	// variant is the name of the ConformanceVariant the test was run under,
+	// or the empty string if no variants were configured.
+	variant string
+}
+
+// profileVariantKey identifies a single (conformance profile, variant) pair,
+// each of which gets its own ProfileReport.
+type profileVariantKey struct {
+	profile ConformanceProfileName
+	variant string
+}
+
+// profileReports accumulates testResults into a confv1a1.ProfileReport per
+// (conformance profile, variant) pair that a test applies to.
+type profileReports struct {
+	reports map[profileVariantKey]*confv1a1.ProfileReport
+}
+
+// newReports returns an empty profileReports accumulator.
+func newReports() *profileReports {
+	return &profileReports{
+		reports: make(map[profileVariantKey]*confv1a1.ProfileReport),
+	}
+}
+
+// reportFor returns the ProfileReport for the given profile/variant pair,
+// creating it if it doesn't already exist.
+func (p *profileReports) reportFor(name ConformanceProfileName, variant string) *confv1a1.ProfileReport {
+	key := profileVariantKey{profile: name, variant: variant}
+	report, ok := p.reports[key]
+	if !ok {
+		report = &confv1a1.ProfileReport{Name: string(name), Variant: variant}
+		p.reports[key] = report
+	}
+	return report
+}
+
+// ProfilesForTest returns the names of every registered ConformanceProfile
+// that test belongs to, core or extended, based on the features it
+// declares. It is exported so that Reporter implementations outside this
+// package can group test outcomes by profile without waiting for a full
+// ConformanceReport to be compiled.
+func ProfilesForTest(test ConformanceTest) []ConformanceProfileName {
+	var names []ConformanceProfileName
+	for name, profile := range conformanceProfiles {
+		if featuresIntersect(profile.CoreFeatures, test.Features) || featuresIntersect(profile.ExtendedFeatures, test.Features) {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
+
+// featuresIntersect reports whether test declares any feature in set.
+func featuresIntersect(set sets.Set[SupportedFeature], features []SupportedFeature) bool {
+	for _, feature := range features {
+		if set.Has(feature) {
+			return true
+		}
+	}
+	return false
+}
+
+// addTestResults buckets a single testResult into the report(s) of every
+// conformance profile it applies to, as either a core or an extended result
+// depending on which of the profile's feature sets the test's declared
+// Features land in. A test that matches both is treated as core: extended
+// coverage is for tests that are gated exclusively by an optional feature.
+//
+// Whether an extended test counts as "supported" falls directly out of
+// whether it ran: a feature the implementation didn't declare via
+// SupportedFeatures lands in suite.unsupportedFeatures, which
+// skipReasonFor consults to skip the test before Run ever calls it, so it
+// arrives here with skipped set. There is no separate compiled
+// "supported extended features" set to keep in sync with that decision.
+func (p *profileReports) addTestResults(result testResult) error {
+	for name, profile := range conformanceProfiles {
+		isCore := featuresIntersect(profile.CoreFeatures, result.test.Features)
+		isExtended := !isCore && featuresIntersect(profile.ExtendedFeatures, result.test.Features)
+		if !isCore && !isExtended {
+			continue
+		}
+
+		report := p.reportFor(name, result.variant)
+
+		gatingFeatures := profile.ExtendedFeatures
+		if isCore {
+			gatingFeatures = profile.CoreFeatures
+		}
+		for _, feature := range result.test.Features {
+			if gatingFeatures.Has(feature) {
+				if report.FeatureByTest == nil {
+					report.FeatureByTest = make(map[string]string)
+				}
+				report.FeatureByTest[result.test.ShortName] = string(feature)
+				break
+			}
+		}
+
+		switch {
+		case isCore:
+			switch {
+			case result.skipped:
+				report.SkippedTests = append(report.SkippedTests, result.test.ShortName)
+			case result.succeeded:
+				report.CorePassed = append(report.CorePassed, result.test.ShortName)
+				if result.flaked {
+					report.FlakedTests = append(report.FlakedTests, result.test.ShortName)
+				}
+			default:
+				report.CoreFailed = append(report.CoreFailed, result.test.ShortName)
+			}
+		default: // isExtended
+			if result.succeeded && !result.skipped {
+				report.ExtendedSupported = append(report.ExtendedSupported, result.test.ShortName)
+				if result.flaked {
+					report.FlakedTests = append(report.FlakedTests, result.test.ShortName)
+				}
+			} else {
+				report.ExtendedUnsupported = append(report.ExtendedUnsupported, result.test.ShortName)
+			}
+		}
+	}
+	return nil
+}
+
+// compileResults finalizes bookkeeping across all accumulated profile
+// reports once every testResult has been added. It sorts every list so that
+// report output is deterministic across runs regardless of map iteration
+// order or test completion order.
+func (p *profileReports) compileResults() {
+	for _, report := range p.reports {
+		sort.Strings(report.CorePassed)
+		sort.Strings(report.CoreFailed)
+		sort.Strings(report.SkippedTests)
+		sort.Strings(report.FlakedTests)
+		sort.Strings(report.ExtendedSupported)
+		sort.Strings(report.ExtendedUnsupported)
+	}
+}
+
+// list returns the accumulated ProfileReports, sorted by profile name and
+// then by variant so that report output is deterministic across runs.
+func (p *profileReports) list() []confv1a1.ProfileReport {
+	reports := make([]confv1a1.ProfileReport, 0, len(p.reports))
+	for _, report := range p.reports {
+		reports = append(reports, *report)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		if reports[i].Name != reports[j].Name {
+			return reports[i].Name < reports[j].Name
+		}
+		return reports[i].Variant < reports[j].Variant
+	})
+	return reports
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileReportsAddTestResults(t *testing.T) {
+	reports := newReports()
+
+	// HTTPRoute is a core HTTP feature: passing, failing, and skipped core
+	// results should land in CorePassed/CoreFailed/SkippedTests.
+	require.NoError(t, reports.addTestResults(testResult{
+		test:      ConformanceTest{ShortName: "HTTPRouteBasic", Features: []SupportedFeature{SupportHTTPRoute}},
+		succeeded: true,
+	}))
+	require.NoError(t, reports.addTestResults(testResult{
+		test:      ConformanceTest{ShortName: "HTTPRouteBroken", Features: []SupportedFeature{SupportHTTPRoute}},
+		succeeded: false,
+	}))
+	require.NoError(t, reports.addTestResults(testResult{
+		test:      ConformanceTest{ShortName: "HTTPRouteSkipped", Features: []SupportedFeature{SupportHTTPRoute}},
+		succeeded: true,
+		skipped:   true,
+	}))
+
+	// HTTPRouteQueryParamMatching is an extended-only HTTP feature: a
+	// passing result is ExtendedSupported, a skipped one is
+	// ExtendedUnsupported, and a flaked pass appears in both ExtendedSupported
+	// and FlakedTests.
+	require.NoError(t, reports.addTestResults(testResult{
+		test:      ConformanceTest{ShortName: "QueryParamMatching", Features: []SupportedFeature{SupportHTTPRouteQueryParamMatching}},
+		succeeded: true,
+	}))
+	require.NoError(t, reports.addTestResults(testResult{
+		test:      ConformanceTest{ShortName: "QueryParamMatchingUnsupported", Features: []SupportedFeature{SupportHTTPRouteQueryParamMatching}},
+		succeeded: true,
+		skipped:   true,
+	}))
+	require.NoError(t, reports.addTestResults(testResult{
+		test:      ConformanceTest{ShortName: "QueryParamMatchingFlaked", Features: []SupportedFeature{SupportHTTPRouteQueryParamMatching}},
+		succeeded: true,
+		attempts:  2,
+		flaked:    true,
+	}))
+
+	reports.compileResults()
+
+	list := reports.list()
+	require.Len(t, list, 1, "only the HTTP profile applies to any of these tests")
+
+	httpReport := list[0]
+	require.Equal(t, string(HTTPConformanceProfileName), httpReport.Name)
+	require.Equal(t, []string{"HTTPRouteBasic"}, httpReport.CorePassed)
+	require.Equal(t, []string{"HTTPRouteBroken"}, httpReport.CoreFailed)
+	require.Equal(t, []string{"HTTPRouteSkipped"}, httpReport.SkippedTests)
+	require.Equal(t, []string{"QueryParamMatching", "QueryParamMatchingFlaked"}, httpReport.ExtendedSupported)
+	require.Equal(t, []string{"QueryParamMatchingUnsupported"}, httpReport.ExtendedUnsupported)
+	require.Equal(t, []string{"QueryParamMatchingFlaked"}, httpReport.FlakedTests)
+}
+
+func TestProfileReportsListSortsByNameThenVariant(t *testing.T) {
+	reports := newReports()
+
+	require.NoError(t, reports.addTestResults(testResult{
+		test:      ConformanceTest{ShortName: "MeshBasic", Features: []SupportedFeature{SupportMesh}},
+		succeeded: true,
+		variant:   "envoy",
+	}))
+	require.NoError(t, reports.addTestResults(testResult{
+		test:      ConformanceTest{ShortName: "MeshBasic", Features: []SupportedFeature{SupportMesh}},
+		succeeded: true,
+		variant:   "nginx",
+	}))
+	require.NoError(t, reports.addTestResults(testResult{
+		test:      ConformanceTest{ShortName: "HTTPRouteBasic", Features: []SupportedFeature{SupportHTTPRoute}},
+		succeeded: true,
+	}))
+
+	reports.compileResults()
+
+	list := reports.list()
+	require.Len(t, list, 3)
+	require.Equal(t, string(HTTPConformanceProfileName), list[0].Name)
+	require.Equal(t, string(MeshConformanceProfileName), list[1].Name)
+	require.Equal(t, "envoy", list[1].Variant)
+	require.Equal(t, string(MeshConformanceProfileName), list[2].Name)
+	require.Equal(t, "nginx", list[2].Variant)
+}
+
+func TestProfilesForTest(t *testing.T) {
+	require.Equal(t, []ConformanceProfileName{HTTPConformanceProfileName}, ProfilesForTest(ConformanceTest{
+		Features: []SupportedFeature{SupportHTTPRouteQueryParamMatching},
+	}))
+	require.Empty(t, ProfilesForTest(ConformanceTest{
+		Features: []SupportedFeature{SupportedFeature("NotARealFeature")},
+	}))
+}
@@ -0,0 +1,100 @@
+//go:build experimental
+// +build experimental
+
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	confv1a1 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha1"
+)
+
+// TestOutcome describes the final outcome of a single ConformanceTest run,
+// for consumption by Reporter implementations outside this package.
+type TestOutcome struct {
+	Test      ConformanceTest
+	Succeeded bool
+	Skipped   bool
+
+	// Attempts is the number of times the test was run before this
+	// outcome was reached.
+	Attempts int
+
+	// Flaked indicates the test failed at least once before eventually
+	// succeeding.
+	Flaked bool
+
+	// Variant is the name of the ConformanceVariant the test was run
+	// under, or the empty string if no variants were configured.
+	Variant string
+}
+
+// Reporter is notified of conformance test lifecycle events as a suite
+// runs, in addition to those events being aggregated into the
+// ConformanceReport returned by Report(). Implementations are called
+// synchronously around each test, so that events relying on them (e.g.
+// incrementally written files) survive the process being killed mid-run.
+type Reporter interface {
+	// OnTestStart is called before each test is run or skipped, ahead of
+	// its first attempt if it runs.
+	OnTestStart(test ConformanceTest)
+
+	// OnTestFinish is called once a test has reached its final outcome,
+	// after all retries (if any) have been exhausted.
+	OnTestFinish(outcome TestOutcome)
+
+	// OnSuiteFinish is called once a ConformanceReport has been compiled
+	// for a completed run.
+	OnSuiteFinish(report *confv1a1.ConformanceReport)
+}
+
+// notifyTestStart notifies every configured Reporter that test is about to
+// run.
+func (suite *ExperimentalConformanceTestSuite) notifyTestStart(test ConformanceTest) {
+	for _, reporter := range suite.reporters {
+		reporter.OnTestStart(test)
+	}
+}
+
+// notifyTestFinish notifies every configured Reporter of a test's final
+// outcome.
+func (suite *ExperimentalConformanceTestSuite) notifyTestFinish(outcome TestOutcome) {
+	for _, reporter := range suite.reporters {
+		reporter.OnTestFinish(outcome)
+	}
+}
+
+// notifySuiteFinish notifies every configured Reporter that a
+// ConformanceReport has been compiled.
+func (suite *ExperimentalConformanceTestSuite) notifySuiteFinish(report *confv1a1.ConformanceReport) {
+	for _, reporter := range suite.reporters {
+		reporter.OnSuiteFinish(report)
+	}
+}
+
+// outcomeFor converts an internal testResult into the TestOutcome shape
+// exposed to Reporter implementations.
+func outcomeFor(result testResult) TestOutcome {
+	return TestOutcome{
+		Test:      result.test,
+		Succeeded: result.succeeded,
+		Skipped:   result.skipped,
+		Attempts:  result.attempts,
+		Flaked:    result.flaked,
+		Variant:   result.variant,
+	}
+}
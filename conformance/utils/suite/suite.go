@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+)
+
+// ConformanceTestSuite defines the test suite used to run Gateway API
+// conformance tests.
+type ConformanceTestSuite struct {
+	Client            client.Client
+	RoundTripper      roundtripper.RoundTripper
+	GatewayClassName  string
+	ControllerName    string
+	Debug             bool
+	Cleanup           bool
+	BaseManifests     string
+	Applier           kubernetes.Applier
+	SupportedFeatures sets.Set[SupportedFeature]
+	TimeoutConfig     config.TimeoutConfig
+	SkipTests         sets.Set[string]
+}
+
+// Options can be used to initialize a ConformanceTestSuite.
+type Options struct {
+	Client       client.Client
+	RoundTripper roundtripper.RoundTripper
+
+	GatewayClassName string
+	Debug            bool
+
+	CleanupBaseResources     bool
+	BaseManifests            string
+	NamespaceLabels          map[string]string
+	ValidUniqueListenerPorts []int32
+
+	TimeoutConfig config.TimeoutConfig
+
+	SupportedFeatures          sets.Set[SupportedFeature]
+	EnableAllSupportedFeatures bool
+	SkipTests                  []string
+}
+
+// ConformanceTest is used to define each individual conformance test.
+type ConformanceTest struct {
+	ShortName   string
+	Description string
+	Manifests   []string
+	Features    []SupportedFeature
+
+	// SourceFile is the path to the Go source file that defines this test,
+	// relative to the repository root. It is optional, and is used by
+	// reporters (e.g. a CI annotation reporter) that can point failures
+	// back at their origin when it's set.
+	SourceFile string
+
+	Run func(t *testing.T, suite *ConformanceTestSuite)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// SupportedFeature allows opting in to additional conformance tests at an
+// individual feature granularity.
+type SupportedFeature string
+
+const (
+	// SupportGateway indicates support for core Gateway functionality.
+	SupportGateway SupportedFeature = "Gateway"
+
+	// SupportHTTPRoute indicates support for core HTTPRoute functionality.
+	SupportHTTPRoute SupportedFeature = "HTTPRoute"
+
+	// SupportHTTPRouteQueryParamMatching indicates support for matching
+	// HTTPRoute requests by query parameter.
+	SupportHTTPRouteQueryParamMatching SupportedFeature = "HTTPRouteQueryParamMatching"
+
+	// SupportTLSRoute indicates support for TLSRoute, a core feature of the
+	// TLS conformance profile.
+	SupportTLSRoute SupportedFeature = "TLSRoute"
+
+	// SupportMesh indicates support for the Mesh conformance profile.
+	SupportMesh SupportedFeature = "Mesh"
+)
+
+// AllFeatures contains all the supported features and can be used to run all
+// conformance tests with --all-features flag.
+var AllFeatures = sets.New(
+	SupportGateway,
+	SupportHTTPRoute,
+	SupportHTTPRouteQueryParamMatching,
+	SupportTLSRoute,
+	SupportMesh,
+)
+
+// ConformanceProfileName is used to define conformance profile names.
+type ConformanceProfileName string
+
+const (
+	// HTTPConformanceProfileName indicates the name of the core HTTP
+	// conformance profile.
+	HTTPConformanceProfileName ConformanceProfileName = "HTTP"
+
+	// TLSConformanceProfileName indicates the name of the core TLS
+	// conformance profile.
+	TLSConformanceProfileName ConformanceProfileName = "TLS"
+
+	// MeshConformanceProfileName indicates the name of the core Mesh
+	// conformance profile.
+	MeshConformanceProfileName ConformanceProfileName = "Mesh"
+)
+
+// ConformanceProfile is a group of features that have a related purpose, e.g.
+// to cover specific protocol support.
+type ConformanceProfile struct {
+	Name         ConformanceProfileName
+	CoreFeatures sets.Set[SupportedFeature]
+
+	// ExtendedFeatures are features of this profile which are not required
+	// for core conformance, but which implementations may optionally
+	// support and have that support verified and reported on.
+	ExtendedFeatures sets.Set[SupportedFeature]
+}
+
+// conformanceProfiles is the list of registered ConformanceProfiles.
+var conformanceProfiles = map[ConformanceProfileName]ConformanceProfile{
+	HTTPConformanceProfileName: {
+		Name:             HTTPConformanceProfileName,
+		CoreFeatures:     sets.New(SupportGateway, SupportHTTPRoute),
+		ExtendedFeatures: sets.New(SupportHTTPRouteQueryParamMatching),
+	},
+	TLSConformanceProfileName: {
+		Name:         TLSConformanceProfileName,
+		CoreFeatures: sets.New(SupportGateway, SupportTLSRoute),
+	},
+	MeshConformanceProfileName: {
+		Name:         MeshConformanceProfileName,
+		CoreFeatures: sets.New(SupportMesh),
+	},
+}
+
+// getConformanceProfileForName retrieves a known ConformanceProfile by name.
+func getConformanceProfileForName(name ConformanceProfileName) (ConformanceProfile, error) {
+	profile, ok := conformanceProfiles[name]
+	if !ok {
+		return ConformanceProfile{}, fmt.Errorf("%s is not a valid conformance profile", name)
+	}
+	return profile, nil
+}
@@ -0,0 +1,114 @@
+//go:build experimental
+// +build experimental
+
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	confv1a1 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha1"
+)
+
+// defaultWebhookTimeout bounds how long a single webhook delivery may take,
+// so that a slow or hung dashboard endpoint can't stall the conformance run
+// that is posting to it synchronously between tests.
+const defaultWebhookTimeout = 10 * time.Second
+
+// WebhookReporter POSTs each suite lifecycle event as JSON to URL, for
+// real-time dashboards watching a conformance run. Delivery is best-effort:
+// errors are swallowed, since Reporter methods have no error return.
+type WebhookReporter struct {
+	// URL is the endpoint events are POSTed to.
+	URL string
+
+	// Client is used to make the requests. Defaults to an http.Client with
+	// a defaultWebhookTimeout timeout.
+	Client *http.Client
+}
+
+type webhookTestStartedEvent struct {
+	Event     string `json:"event"`
+	ShortName string `json:"shortName"`
+}
+
+type webhookTestFinishedEvent struct {
+	Event     string `json:"event"`
+	ShortName string `json:"shortName"`
+	Succeeded bool   `json:"succeeded"`
+	Skipped   bool   `json:"skipped"`
+	Attempts  int    `json:"attempts"`
+	Flaked    bool   `json:"flaked"`
+	Variant   string `json:"variant,omitempty"`
+}
+
+type webhookSuiteFinishedEvent struct {
+	Event  string                      `json:"event"`
+	Report *confv1a1.ConformanceReport `json:"report"`
+}
+
+func (r *WebhookReporter) httpClient() *http.Client {
+	if r.Client != nil {
+		return r.Client
+	}
+	return &http.Client{Timeout: defaultWebhookTimeout}
+}
+
+// OnTestStart implements Reporter.
+func (r *WebhookReporter) OnTestStart(test ConformanceTest) {
+	r.post(webhookTestStartedEvent{Event: "test_started", ShortName: test.ShortName})
+}
+
+// OnTestFinish implements Reporter.
+func (r *WebhookReporter) OnTestFinish(outcome TestOutcome) {
+	r.post(webhookTestFinishedEvent{
+		Event:     "test_finished",
+		ShortName: outcome.Test.ShortName,
+		Succeeded: outcome.Succeeded,
+		Skipped:   outcome.Skipped,
+		Attempts:  outcome.Attempts,
+		Flaked:    outcome.Flaked,
+		Variant:   outcome.Variant,
+	})
+}
+
+// OnSuiteFinish implements Reporter.
+func (r *WebhookReporter) OnSuiteFinish(report *confv1a1.ConformanceReport) {
+	r.post(webhookSuiteFinishedEvent{Event: "suite_finished", Report: report})
+}
+
+// post best-effort delivers payload as JSON to URL.
+func (r *WebhookReporter) post(payload any) {
+	if r.URL == "" {
+		return
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return
+	}
+
+	resp, err := r.httpClient().Post(r.URL, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return
+	}
+	resp.Body.Close()
+}
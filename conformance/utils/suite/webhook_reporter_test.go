@@ -0,0 +1,73 @@
+//go:build experimental
+// +build experimental
+
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	confv1a1 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha1"
+)
+
+func TestWebhookReporterPostsLifecycleEvents(t *testing.T) {
+	var mu sync.Mutex
+	var received []map[string]any
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+
+		mu.Lock()
+		received = append(received, payload)
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	reporter := &WebhookReporter{URL: server.URL}
+
+	reporter.OnTestStart(ConformanceTest{ShortName: "Basic"})
+	reporter.OnTestFinish(TestOutcome{Test: ConformanceTest{ShortName: "Basic"}, Succeeded: true, Attempts: 1})
+	reporter.OnSuiteFinish(&confv1a1.ConformanceReport{GatewayAPIVersion: "v1.0.0"})
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, received, 3)
+	require.Equal(t, "test_started", received[0]["event"])
+	require.Equal(t, "Basic", received[0]["shortName"])
+	require.Equal(t, "test_finished", received[1]["event"])
+	require.Equal(t, true, received[1]["succeeded"])
+	require.Equal(t, "suite_finished", received[2]["event"])
+	report, ok := received[2]["report"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "v1.0.0", report["gatewayAPIVersion"])
+}
+
+func TestWebhookReporterSkipsWhenURLUnset(t *testing.T) {
+	reporter := &WebhookReporter{}
+	// Must not panic or block on a nil/unset destination.
+	reporter.OnTestStart(ConformanceTest{ShortName: "Basic"})
+}